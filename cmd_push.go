@@ -194,16 +194,18 @@ func pushWorker(sps *sharedPushState) {
 			// loop, since the config for these may var per dir!
 			mods.AllowUnsafe = t.Dir.Config.GetBool("allow-unsafe") || sps.briefOutput
 			mods.StrictIndexOrder = t.Dir.Config.GetBool("exact-match")
-			mods.AlgorithmClause, err = t.Dir.Config.GetEnum("alter-algorithm", "INPLACE", "COPY", "DEFAULT")
+			algorithm, err := t.Dir.Config.GetEnum("alter-algorithm", "INPLACE", "COPY", "DEFAULT")
 			if err != nil {
 				sps.setFatalError(NewExitValue(CodeBadConfig, err.Error()))
 				return
 			}
-			mods.LockClause, err = t.Dir.Config.GetEnum("alter-lock", "NONE", "SHARED", "EXCLUSIVE", "DEFAULT")
+			mods.AlgorithmClause = tengo.AlterAlgorithm(algorithm)
+			lock, err := t.Dir.Config.GetEnum("alter-lock", "NONE", "SHARED", "EXCLUSIVE", "DEFAULT")
 			if err != nil {
 				sps.setFatalError(NewExitValue(CodeBadConfig, err.Error()))
 				return
 			}
+			mods.LockClause = tengo.AlterLock(lock)
 			mods.IgnoreTable, err = t.Dir.Config.GetRegexp("ignore-table")
 			if err != nil {
 				sps.setFatalError(NewExitValue(CodeBadConfig, err.Error()))